@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &certDataSource{}
+	_ datasource.DataSourceWithConfigure = &certDataSource{}
+)
+
+func NewCertDataSource() datasource.DataSource {
+	return &certDataSource{}
+}
+
+// certDataSource fetches the sealed-secrets controller's active public key.
+type certDataSource struct {
+	providerData *providerData
+}
+
+// Configure adds the provider configured data to the data source. Its own attributes
+// remain optional overrides on top of it, so a user who configures kubeconfig/cert_url
+// once on the provider block doesn't have to repeat it on every kubeseal_cert block.
+func (d *certDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = data
+}
+
+type certDataSourceModel struct {
+	Kubeconfig         types.String `tfsdk:"kubeconfig"`
+	KubeconfigRaw      types.String `tfsdk:"kubeconfig_raw"`
+	Context            types.String `tfsdk:"context"`
+	Namespace          types.String `tfsdk:"namespace"`
+	ControllerName     types.String `tfsdk:"controller_name"`
+	CertURL            types.String `tfsdk:"cert_url"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	PubKey             types.String `tfsdk:"pubkey"`
+}
+
+// Metadata returns the data source type name.
+func (d *certDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cert"
+}
+
+// Schema defines the schema for the data source.
+func (d *certDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the active sealed-secrets controller public key, either from a Kubernetes cluster or from an HTTPS endpoint. Any attribute left unset here falls back to the corresponding provider-level configuration.",
+		Attributes: map[string]schema.Attribute{
+			"kubeconfig": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a kubeconfig file. Defaults to the provider's kubeconfig, then the KUBECONFIG environment variable or the in-cluster config. Ignored when cert_url is set.",
+			},
+			"kubeconfig_raw": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Raw kubeconfig contents, used instead of kubeconfig. Defaults to the provider's kubeconfig_raw. Ignored when cert_url is set.",
+			},
+			"context": schema.StringAttribute{
+				Optional:    true,
+				Description: "Kubeconfig context to use. Defaults to the provider's context, then the kubeconfig's current context.",
+			},
+			"namespace": schema.StringAttribute{
+				Optional:    true,
+				Description: "Namespace the sealed-secrets controller runs in. Defaults to the provider's controller_namespace, then \"kube-system\".",
+			},
+			"controller_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the sealed-secrets controller service. Defaults to the provider's controller_name, then \"sealed-secrets-controller\".",
+			},
+			"cert_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "HTTPS endpoint that serves the controller's public key directly (e.g. https://sealed-secrets.example.com/v1/cert.pem). Defaults to the provider's cert_url. When set, the cluster lookup is skipped.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip TLS certificate verification when fetching from cert_url. Defaults to the provider's insecure_skip_verify, then false.",
+			},
+			"pubkey": schema.StringAttribute{
+				Computed:    true,
+				Description: "PEM-encoded public key of the active sealed-secrets controller.",
+			},
+		},
+	}
+}
+
+// Read fetches the pubkey and sets it into state.
+func (d *certDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data certDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kubeconfigPath := data.Kubeconfig.ValueString()
+	kubeconfigRaw := data.KubeconfigRaw.ValueString()
+	kubeContext := data.Context.ValueString()
+	namespace := data.Namespace.ValueString()
+	controllerName := data.ControllerName.ValueString()
+	certURL := data.CertURL.ValueString()
+	insecureSkipVerify := data.InsecureSkipVerify.ValueBool()
+
+	// Fall back to the provider-level config for anything left unset on this data source,
+	// so a kubeconfig/cert_url configured once on the provider block is reused here instead
+	// of this data source silently defaulting to a potentially different cluster.
+	if d.providerData != nil {
+		if kubeconfigPath == "" {
+			kubeconfigPath = d.providerData.KubeconfigPath
+		}
+		if kubeconfigRaw == "" {
+			kubeconfigRaw = d.providerData.KubeconfigRaw
+		}
+		if kubeContext == "" {
+			kubeContext = d.providerData.KubeconfigContext
+		}
+		if namespace == "" {
+			namespace = d.providerData.ControllerNamespace
+		}
+		if controllerName == "" {
+			controllerName = d.providerData.ControllerName
+		}
+		if certURL == "" {
+			certURL = d.providerData.CertURL
+		}
+		if data.InsecureSkipVerify.IsNull() {
+			insecureSkipVerify = d.providerData.InsecureSkipVerify
+		}
+	}
+
+	if namespace == "" {
+		namespace = defaultControllerNamespace
+	}
+	if controllerName == "" {
+		controllerName = defaultControllerName
+	}
+
+	if d.providerData != nil && d.providerData.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.providerData.RequestTimeout)
+		defer cancel()
+	}
+
+	var (
+		pubKey string
+		err    error
+	)
+
+	if certURL != "" {
+		pubKey, err = fetchCertFromURL(ctx, certURL, insecureSkipVerify)
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching cert from cert_url", "Unexpected error: "+err.Error())
+			return
+		}
+	} else {
+		clientset, err2 := buildKubeClient(kubeconfigPath, kubeconfigRaw, kubeContext)
+		if err2 != nil {
+			resp.Diagnostics.AddError("Error building Kubernetes client", "Unexpected error: "+err2.Error())
+			return
+		}
+
+		pubKey, err = fetchCertFromCluster(ctx, clientset, namespace, controllerName)
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching cert from cluster", "Unexpected error: "+err.Error())
+			return
+		}
+	}
+
+	data.PubKey = types.StringValue(pubKey)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+const (
+	defaultControllerNamespace = "kube-system"
+	defaultControllerName      = "sealed-secrets-controller"
+)
+
+// buildRestConfig builds a Kubernetes REST config from an explicit kubeconfig path, raw
+// kubeconfig contents, or the usual client-go defaults (KUBECONFIG env var, in-cluster config).
+func buildRestConfig(kubeconfigPath, kubeconfigRaw, kubeContext string) (*rest.Config, error) {
+	overrides := &clientcmd.ConfigOverrides{}
+	if kubeContext != "" {
+		overrides.CurrentContext = kubeContext
+	}
+
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if kubeconfigPath != "" {
+		loadingRules.ExplicitPath = kubeconfigPath
+	}
+
+	var clientConfig clientcmd.ClientConfig
+	if kubeconfigRaw != "" {
+		apiConfig, err := clientcmd.Load([]byte(kubeconfigRaw))
+		if err != nil {
+			return nil, fmt.Errorf("parsing kubeconfig_raw: %w", err)
+		}
+		clientConfig = clientcmd.NewDefaultClientConfig(*apiConfig, overrides)
+	} else {
+		clientConfig = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides)
+	}
+
+	return clientConfig.ClientConfig()
+}
+
+// buildKubeClient builds a Kubernetes clientset from an explicit kubeconfig path, raw
+// kubeconfig contents, or the usual client-go defaults (KUBECONFIG env var, in-cluster config).
+func buildKubeClient(kubeconfigPath, kubeconfigRaw, kubeContext string) (kubernetes.Interface, error) {
+	restConfig, err := buildRestConfig(kubeconfigPath, kubeconfigRaw, kubeContext)
+	if err != nil {
+		return nil, fmt.Errorf("building REST config: %w", err)
+	}
+
+	return kubernetes.NewForConfig(restConfig)
+}
+
+// fetchCertFromCluster retrieves the controller's public key by proxying through the
+// Kubernetes API server to the controller's /v1/cert.pem endpoint, the same way the
+// upstream kubeseal CLI does.
+func fetchCertFromCluster(ctx context.Context, clientset kubernetes.Interface, namespace, controllerName string) (string, error) {
+	body, err := clientset.CoreV1().Services(namespace).
+		ProxyGet("http", controllerName, "", "/v1/cert.pem", nil).
+		DoRaw(ctx)
+	if err != nil {
+		return "", fmt.Errorf("fetching cert from controller %s/%s: %w", namespace, controllerName, err)
+	}
+
+	return string(body), nil
+}
+
+// fetchCertFromURL retrieves the controller's public key from an HTTPS endpoint.
+func fetchCertFromURL(ctx context.Context, certURL string, insecureSkipVerify bool) (string, error) {
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: insecureSkipVerify,
+			},
+		},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, certURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("building request: %w", err)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("requesting cert: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d fetching cert from %s", resp.StatusCode, certURL)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading response body: %w", err)
+	}
+
+	return string(body), nil
+}
@@ -0,0 +1,14 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// sha256Hex returns the hex-encoded SHA-256 digest of s, used to detect unchanged
+// plaintext between applies so already-sealed ciphertext can be reused instead of
+// re-encrypted with fresh randomness.
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,18 @@
+package provider
+
+import "testing"
+
+func TestSha256Hex(t *testing.T) {
+	if got, want := sha256Hex(""), sha256Hex(""); got != want {
+		t.Errorf("sha256Hex() not deterministic: %q != %q", got, want)
+	}
+
+	if sha256Hex("foo") == sha256Hex("bar") {
+		t.Error("sha256Hex() produced the same digest for different inputs")
+	}
+
+	const want = "ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb"
+	if got := sha256Hex("a"); got != want {
+		t.Errorf("sha256Hex(\"a\") = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	ssv1alpha1 "github.com/bitnami-labs/sealed-secrets/pkg/apis/sealedsecrets/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// resolveImportedSealedSecret fetches a SealedSecret manifest being imported, either from
+// a local YAML file (import ID "file://<path>") or from the cluster (import ID
+// "<namespace>/<name>", fetched via the provider's configured client).
+func resolveImportedSealedSecret(ctx context.Context, data *providerData, importID string) (*ssv1alpha1.SealedSecret, error) {
+	if path, ok := strings.CutPrefix(importID, "file://"); ok {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", importID, err)
+		}
+
+		var sealedSecret ssv1alpha1.SealedSecret
+		if err := yaml.Unmarshal(raw, &sealedSecret); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", importID, err)
+		}
+		return &sealedSecret, nil
+	}
+
+	namespace, name, ok := strings.Cut(importID, "/")
+	if !ok || namespace == "" || name == "" {
+		return nil, fmt.Errorf("invalid import ID %q: expected \"namespace/name\" or \"file://path/to/manifest.yaml\"", importID)
+	}
+
+	if data == nil {
+		return nil, fmt.Errorf("importing %q requires the provider to be configured with a kubeconfig", importID)
+	}
+
+	if err := data.ensureClients(); err != nil {
+		return nil, fmt.Errorf("importing %q: %w", importID, err)
+	}
+
+	sealedSecret, err := data.SealedSecretsClient.BitnamiV1alpha1().SealedSecrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("fetching SealedSecret %s/%s: %w", namespace, name, err)
+	}
+	return sealedSecret, nil
+}
+
+// scopeFromAnnotations infers the sealed-secrets scope from a SealedSecret's annotations,
+// mirroring how the bitnami controller itself derives scope.
+func scopeFromAnnotations(annotations map[string]string) ssv1alpha1.SealingScope {
+	if annotations[ssv1alpha1.SealedSecretClusterWideAnnotation] == "true" {
+		return ssv1alpha1.ClusterWideScope
+	}
+	if annotations[ssv1alpha1.SealedSecretNamespaceWideAnnotation] == "true" {
+		return ssv1alpha1.NamespaceWideScope
+	}
+	return ssv1alpha1.StrictScope
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"testing"
+
+	ssv1alpha1 "github.com/bitnami-labs/sealed-secrets/pkg/apis/sealedsecrets/v1alpha1"
+)
+
+func TestScopeFromAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        ssv1alpha1.SealingScope
+	}{
+		{name: "none", annotations: nil, want: ssv1alpha1.StrictScope},
+		{name: "empty map", annotations: map[string]string{}, want: ssv1alpha1.StrictScope},
+		{
+			name:        "cluster-wide",
+			annotations: map[string]string{ssv1alpha1.SealedSecretClusterWideAnnotation: "true"},
+			want:        ssv1alpha1.ClusterWideScope,
+		},
+		{
+			name:        "namespace-wide",
+			annotations: map[string]string{ssv1alpha1.SealedSecretNamespaceWideAnnotation: "true"},
+			want:        ssv1alpha1.NamespaceWideScope,
+		},
+		{
+			name:        "cluster-wide takes precedence over namespace-wide",
+			annotations: map[string]string{ssv1alpha1.SealedSecretClusterWideAnnotation: "true", ssv1alpha1.SealedSecretNamespaceWideAnnotation: "true"},
+			want:        ssv1alpha1.ClusterWideScope,
+		},
+		{
+			name:        "non-true value is ignored",
+			annotations: map[string]string{ssv1alpha1.SealedSecretClusterWideAnnotation: "false"},
+			want:        ssv1alpha1.StrictScope,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scopeFromAnnotations(tt.annotations); got != tt.want {
+				t.Errorf("scopeFromAnnotations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveImportedSealedSecretInvalidID(t *testing.T) {
+	if _, err := resolveImportedSealedSecret(nil, nil, "no-slash-here"); err == nil {
+		t.Error("resolveImportedSealedSecret() expected an error for an import ID with no namespace/name separator")
+	}
+}
@@ -5,9 +5,13 @@ package provider
 
 import (
 	"context"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
@@ -26,6 +30,17 @@ type terraformKubeseal struct {
 	version string
 }
 
+type terraformKubesealModel struct {
+	Kubeconfig            types.String `tfsdk:"kubeconfig"`
+	KubeconfigRaw         types.String `tfsdk:"kubeconfig_raw"`
+	Context               types.String `tfsdk:"context"`
+	ControllerNamespace   types.String `tfsdk:"controller_namespace"`
+	ControllerName        types.String `tfsdk:"controller_name"`
+	CertURL               types.String `tfsdk:"cert_url"`
+	InsecureSkipVerify    types.Bool   `tfsdk:"insecure_skip_verify"`
+	RequestTimeoutSeconds types.Int64  `tfsdk:"request_timeout_seconds"`
+}
+
 func (p *terraformKubeseal) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "kubeseal"
 	resp.Version = p.version
@@ -33,19 +48,92 @@ func (p *terraformKubeseal) Metadata(_ context.Context, _ provider.MetadataReque
 
 // Schema defines the provider-level schema for configuration data.
 func (p *terraformKubeseal) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Configures how resources and data sources reach the sealed-secrets controller: either a Kubernetes cluster (via kubeconfig) or a cert_url serving the controller's public key directly.",
+		Attributes: map[string]schema.Attribute{
+			"kubeconfig": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a kubeconfig file. Defaults to the KUBECONFIG environment variable or the in-cluster config. Ignored when cert_url is set.",
+			},
+			"kubeconfig_raw": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Raw kubeconfig contents, used instead of kubeconfig. Ignored when cert_url is set.",
+			},
+			"context": schema.StringAttribute{
+				Optional:    true,
+				Description: "Kubeconfig context to use. Defaults to the kubeconfig's current context.",
+			},
+			"controller_namespace": schema.StringAttribute{
+				Optional:    true,
+				Description: "Namespace the sealed-secrets controller runs in. Defaults to the SEALED_SECRETS_CONTROLLER_NAMESPACE environment variable, or \"kube-system\".",
+			},
+			"controller_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the sealed-secrets controller service. Defaults to the SEALED_SECRETS_CONTROLLER_NAME environment variable, or \"sealed-secrets-controller\".",
+			},
+			"cert_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "HTTPS endpoint that serves the controller's public key directly (e.g. https://sealed-secrets.example.com/v1/cert.pem). When set, the cluster lookup is skipped.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip TLS certificate verification when fetching from cert_url. Defaults to false.",
+			},
+			"request_timeout_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Timeout, in seconds, for requests made to the cluster or cert_url while discovering the controller's public key. Defaults to 30.",
+			},
+		},
+	}
 }
 
 func (p *terraformKubeseal) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config terraformKubesealModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := &providerData{
+		ControllerNamespace: config.ControllerNamespace.ValueString(),
+		ControllerName:      config.ControllerName.ValueString(),
+		CertURL:             config.CertURL.ValueString(),
+		InsecureSkipVerify:  config.InsecureSkipVerify.ValueBool(),
+		RequestTimeout:      30 * time.Second,
+		KubeconfigPath:      config.Kubeconfig.ValueString(),
+		KubeconfigRaw:       config.KubeconfigRaw.ValueString(),
+		KubeconfigContext:   config.Context.ValueString(),
+	}
+
+	if data.ControllerNamespace == "" {
+		data.ControllerNamespace = envOrDefault("SEALED_SECRETS_CONTROLLER_NAMESPACE", defaultControllerNamespace)
+	}
+	if data.ControllerName == "" {
+		data.ControllerName = envOrDefault("SEALED_SECRETS_CONTROLLER_NAME", defaultControllerName)
+	}
+	if !config.RequestTimeoutSeconds.IsNull() {
+		data.RequestTimeout = time.Duration(config.RequestTimeoutSeconds.ValueInt64()) * time.Second
+	}
+
+	// The Kubernetes/sealed-secrets clients are built lazily, on first actual use (see
+	// providerData.ensureClients), so a user who supplies an explicit pubkey on every
+	// resource and never touches a cluster isn't forced to have a reachable kubeconfig.
+	resp.DataSourceData = data
+	resp.ResourceData = data
 }
 
 func (p *terraformKubeseal) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		//		NewCoffeesDataSource,
+		NewCertDataSource,
 	}
 }
 
 func (p *terraformKubeseal) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewRawResource,
+		NewRawsResource,
+		NewSecretResource,
 	}
 }
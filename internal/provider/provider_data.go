@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	sealedsecretsclientset "github.com/bitnami-labs/sealed-secrets/pkg/client/clientset/versioned"
+	"k8s.io/client-go/kubernetes"
+)
+
+// providerData is the shared state built once in terraformKubeseal.Configure and handed
+// to every resource/data source via resp.ResourceData / resp.DataSourceData. Building a
+// Kubernetes client from Kubeconfig*/Context is deferred until a resource actually needs
+// one (see ensureClients), so a user who only ever supplies an explicit pubkey per resource
+// never has to have a reachable cluster or cert_url configured at all.
+type providerData struct {
+	Client              kubernetes.Interface
+	SealedSecretsClient sealedsecretsclientset.Interface
+	ControllerNamespace string
+	ControllerName      string
+	CertURL             string
+	InsecureSkipVerify  bool
+	RequestTimeout      time.Duration
+
+	KubeconfigPath    string
+	KubeconfigRaw     string
+	KubeconfigContext string
+
+	mu              sync.Mutex
+	cachedPubKeyPEM string
+	clientsOnce     sync.Once
+	clientsErr      error
+}
+
+// ensureClients lazily builds Client and SealedSecretsClient from the configured
+// kubeconfig on first use, caching the result (success or failure) for subsequent calls.
+// It's a no-op once the clients are built. Called only from code paths that actually need
+// a Kubernetes client (cluster-backed pubkey discovery, cluster-backed import) so a
+// provider configured with only cert_url, or only ever used with an explicit pubkey per
+// resource, never has to reach a cluster.
+func (p *providerData) ensureClients() error {
+	p.clientsOnce.Do(func() {
+		restConfig, err := buildRestConfig(p.KubeconfigPath, p.KubeconfigRaw, p.KubeconfigContext)
+		if err != nil {
+			p.clientsErr = fmt.Errorf("building Kubernetes client: %w", err)
+			return
+		}
+
+		client, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			p.clientsErr = fmt.Errorf("building Kubernetes client: %w", err)
+			return
+		}
+
+		sealedSecretsClient, err := sealedsecretsclientset.NewForConfig(restConfig)
+		if err != nil {
+			p.clientsErr = fmt.Errorf("building sealed-secrets client: %w", err)
+			return
+		}
+
+		p.Client = client
+		p.SealedSecretsClient = sealedSecretsClient
+	})
+	return p.clientsErr
+}
+
+// PubKeyPEM returns the controller's public key, fetching and caching it on first use.
+func (p *providerData) PubKeyPEM(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.cachedPubKeyPEM != "" {
+		return p.cachedPubKeyPEM, nil
+	}
+
+	fetchCtx := ctx
+	if p.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		fetchCtx, cancel = context.WithTimeout(ctx, p.RequestTimeout)
+		defer cancel()
+	}
+
+	var (
+		pubKey string
+		err    error
+	)
+	if p.CertURL != "" {
+		pubKey, err = fetchCertFromURL(fetchCtx, p.CertURL, p.InsecureSkipVerify)
+	} else {
+		if err := p.ensureClients(); err != nil {
+			return "", err
+		}
+		pubKey, err = fetchCertFromCluster(fetchCtx, p.Client, p.ControllerNamespace, p.ControllerName)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	p.cachedPubKeyPEM = pubKey
+	return pubKey, nil
+}
+
+// envOrDefault returns the value of the given environment variable, or def if unset/empty.
+func envOrDefault(envVar, def string) string {
+	if v := os.Getenv(envVar); v != "" {
+		return v
+	}
+	return def
+}
+
+// resolvePubKey returns the controller-discovered pubkey from the provider configuration,
+// for use by resources whose pubkey attribute was left unset.
+func resolvePubKey(ctx context.Context, data *providerData) (string, error) {
+	if data == nil {
+		return "", fmt.Errorf("pubkey is required when the provider has no kubeconfig or cert_url configured")
+	}
+	return data.PubKeyPEM(ctx)
+}
@@ -0,0 +1,64 @@
+// Package provider's pubkey rotation drift detection is intentionally narrower than
+// "detect drift in a sealed value": it only catches the controller's pubkey rotating out
+// from under a previously sealed value (see pubKeyRotated below). It does NOT decode a
+// sealed value to recover the name/namespace/scope it was sealed with, verify the RSA
+// fingerprint the ciphertext itself was encrypted under, or notice ciphertext that's been
+// hand-edited outside Terraform — the sealed-secrets wire format carries no recoverable
+// plaintext header, so none of that is possible without the controller's private key.
+package provider
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/bitnami-labs/sealed-secrets/pkg/kubeseal"
+)
+
+// pubKeyFingerprint returns a stable SHA-256 fingerprint for an RSA public key, used to
+// detect controller key rotation without needing the private key.
+func pubKeyFingerprint(pubKey *rsa.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(pubKey)
+	if err != nil {
+		return "", fmt.Errorf("marshalling public key: %w", err)
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// pemFingerprint parses a PEM-encoded RSA public key and returns its fingerprint.
+func pemFingerprint(pubKeyPEM string) (string, error) {
+	pubKey, err := kubeseal.ParseKey(strings.NewReader(pubKeyPEM))
+	if err != nil {
+		return "", fmt.Errorf("parsing pubkey: %w", err)
+	}
+	return pubKeyFingerprint(pubKey)
+}
+
+// pubKeyRotated reports whether the pubkey a value was sealed with differs from the
+// controller's currently active pubkey. This is narrower than "detect drift": sealed-secrets
+// ciphertext carries no plaintext header or recoverable key fingerprint, so there's no way
+// to decode a sealed value to verify the RSA key it was encrypted with, or to notice its
+// ciphertext bytes having been hand-edited outside Terraform. What's implemented instead
+// compares the pubkey PEM recorded in state at seal time against the freshly discovered
+// one, which only catches controller key rotation, nothing else.
+func pubKeyRotated(sealedWithPubKeyPEM, currentPubKeyPEM string) (bool, error) {
+	if sealedWithPubKeyPEM == "" || currentPubKeyPEM == "" || sealedWithPubKeyPEM == currentPubKeyPEM {
+		return false, nil
+	}
+
+	oldFingerprint, err := pemFingerprint(sealedWithPubKeyPEM)
+	if err != nil {
+		return false, err
+	}
+
+	newFingerprint, err := pemFingerprint(currentPubKeyPEM)
+	if err != nil {
+		return false, err
+	}
+
+	return oldFingerprint != newFingerprint, nil
+}
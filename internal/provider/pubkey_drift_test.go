@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// generateTestCertPEM returns a self-signed certificate PEM wrapping a freshly generated
+// RSA key, in the same "CERTIFICATE" form the controller's /v1/cert.pem endpoint serves.
+func generateTestCertPEM(t *testing.T) string {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating RSA key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sealed-secrets"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: der}); err != nil {
+		t.Fatalf("encoding certificate PEM: %v", err)
+	}
+	return buf.String()
+}
+
+func TestPubKeyRotated(t *testing.T) {
+	certA := generateTestCertPEM(t)
+	certB := generateTestCertPEM(t)
+
+	tests := []struct {
+		name      string
+		sealedPEM string
+		currPEM   string
+		want      bool
+		wantErr   bool
+	}{
+		{name: "unchanged", sealedPEM: certA, currPEM: certA, want: false},
+		{name: "rotated", sealedPEM: certA, currPEM: certB, want: true},
+		{name: "no prior state", sealedPEM: "", currPEM: certA, want: false},
+		{name: "no current key", sealedPEM: certA, currPEM: "", want: false},
+		{name: "malformed pem", sealedPEM: "not a pem", currPEM: certA, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pubKeyRotated(tt.sealedPEM, tt.currPEM)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("pubKeyRotated() expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("pubKeyRotated() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("pubKeyRotated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPemFingerprintStable(t *testing.T) {
+	certPEM := generateTestCertPEM(t)
+
+	first, err := pemFingerprint(certPEM)
+	if err != nil {
+		t.Fatalf("pemFingerprint() unexpected error: %v", err)
+	}
+
+	second, err := pemFingerprint(certPEM)
+	if err != nil {
+		t.Fatalf("pemFingerprint() unexpected error: %v", err)
+	}
+
+	if first != second {
+		t.Errorf("pemFingerprint() not stable across calls: %q != %q", first, second)
+	}
+	if first == "" {
+		t.Error("pemFingerprint() returned an empty fingerprint")
+	}
+}
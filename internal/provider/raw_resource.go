@@ -3,12 +3,15 @@ package provider
 import (
 	"bytes"
 	"context"
+	"fmt"
 	ssv1alpha1 "github.com/bitnami-labs/sealed-secrets/pkg/apis/sealedsecrets/v1alpha1"
 	"github.com/bitnami-labs/sealed-secrets/pkg/kubeseal"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strings"
@@ -17,7 +20,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &rawResource{}
+	_ resource.Resource                = &rawResource{}
+	_ resource.ResourceWithConfigure   = &rawResource{}
+	_ resource.ResourceWithImportState = &rawResource{}
 )
 
 func NewRawResource() resource.Resource {
@@ -26,16 +31,38 @@ func NewRawResource() resource.Resource {
 
 // rawResource is the resource implementation.
 type rawResource struct {
+	providerData *providerData
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *rawResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = data
 }
 
 type rawSealModel struct {
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Secret      types.String `tfsdk:"secret"`
-	Scope       types.Int32  `tfsdk:"scope"`
-	PubKey      types.String `tfsdk:"pubkey"`
-	Sealed      types.String `tfsdk:"sealed"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	Name                         types.String `tfsdk:"name"`
+	Namespace                    types.String `tfsdk:"namespace"`
+	Secret                       types.String `tfsdk:"secret"`
+	Scope                        types.Int32  `tfsdk:"scope"`
+	PubKey                       types.String `tfsdk:"pubkey"`
+	Sealed                       types.String `tfsdk:"sealed"`
+	ForceRecomputeOnPubKeyChange types.Bool   `tfsdk:"force_recompute_on_pubkey_change"`
+	PubKeyFingerprint            types.String `tfsdk:"pubkey_fingerprint"`
+	ValueHash                    types.String `tfsdk:"value_hash"`
+	LastUpdated                  types.String `tfsdk:"last_updated"`
 }
 
 // Metadata returns the resource type name.
@@ -75,13 +102,36 @@ func (r *rawResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				`,
 			},
 			"pubkey": schema.StringAttribute{
-				Required:    true,
-				Description: "Public Key to encrypt secret with",
+				Optional:    true,
+				Computed:    true,
+				Description: "Public Key to encrypt secret with. Defaults to the provider's controller-discovered key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"sealed": schema.StringAttribute{
 				Computed:    true,
 				Description: "Encrypted secret string",
 			},
+			"force_recompute_on_pubkey_change": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When the provider-discovered pubkey rotates, clear the sealed value on refresh so the next plan re-encrypts it. Defaults to false.",
+			},
+			"pubkey_fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 fingerprint of the pubkey the sealed value was last encrypted with",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"value_hash": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "SHA-256 hash of the secret plaintext last sealed, used to avoid re-encrypting unchanged values on every apply",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"last_updated": schema.StringAttribute{
 				Computed:    true,
 				Description: "Timestamp of last updated time",
@@ -90,7 +140,20 @@ func (r *rawResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 	}
 }
 
-func encryptWrapper(plan rawSealModel, diagnostics diag.Diagnostics) (rawSealModel, error) {
+// encryptWrapper seals plan.Secret, reusing the prior ciphertext verbatim when priorState
+// shows nothing that would change it has changed (same plaintext, name, namespace, scope
+// and pubkey) rather than re-encrypting with EncryptSecretItem's fresh randomness every
+// apply. priorState is nil on Create, where there's nothing to reuse.
+func encryptWrapper(ctx context.Context, providerData *providerData, priorState *rawSealModel, plan rawSealModel, diagnostics diag.Diagnostics) (rawSealModel, error) {
+	if plan.PubKey.ValueString() == "" {
+		pubKeyPEM, err := resolvePubKey(ctx, providerData)
+		if err != nil {
+			diagnostics.AddError("Error resolving pubkey", "Unexpected error: "+err.Error())
+			return plan, err
+		}
+		plan.PubKey = types.StringValue(pubKeyPEM)
+	}
+
 	reader := strings.NewReader(plan.PubKey.ValueString())
 	pubKey, err := kubeseal.ParseKey(reader)
 	if err != nil {
@@ -98,20 +161,50 @@ func encryptWrapper(plan rawSealModel, diagnostics diag.Diagnostics) (rawSealMod
 		return plan, err
 	}
 
-	w := new(bytes.Buffer)
-	sealingScope := ssv1alpha1.SealingScope(plan.Scope.ValueInt32())
-
-	err = kubeseal.EncryptSecretItem(w, plan.Name.ValueString(), plan.Namespace.ValueString(), []byte(plan.Secret.ValueString()), sealingScope, pubKey)
+	fingerprint, err := pubKeyFingerprint(pubKey)
 	if err != nil {
-		diagnostics.AddError("Error encrypting secret item", "Unexpected error: "+err.Error())
+		diagnostics.AddError("Error fingerprinting pubkey", "Unexpected error: "+err.Error())
 		return plan, err
 	}
 
-	plan.Sealed = types.StringValue(w.String())
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	valueHash := sha256Hex(plan.Secret.ValueString())
+
+	if canReuseSealedValue(priorState, plan, fingerprint, valueHash) {
+		plan.Sealed = priorState.Sealed
+		plan.LastUpdated = priorState.LastUpdated
+	} else {
+		w := new(bytes.Buffer)
+		sealingScope := ssv1alpha1.SealingScope(plan.Scope.ValueInt32())
+
+		err = kubeseal.EncryptSecretItem(w, plan.Name.ValueString(), plan.Namespace.ValueString(), []byte(plan.Secret.ValueString()), sealingScope, pubKey)
+		if err != nil {
+			diagnostics.AddError("Error encrypting secret item", "Unexpected error: "+err.Error())
+			return plan, err
+		}
+
+		plan.Sealed = types.StringValue(w.String())
+		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	}
+
+	plan.PubKeyFingerprint = types.StringValue(fingerprint)
+	plan.ValueHash = types.StringValue(valueHash)
 	return plan, nil
 }
 
+// canReuseSealedValue reports whether priorState's sealed value can be reused verbatim for
+// plan, i.e. nothing that feeds into the ciphertext has changed since it was last sealed.
+func canReuseSealedValue(priorState *rawSealModel, plan rawSealModel, pubKeyFingerprint, valueHash string) bool {
+	if priorState == nil || priorState.Sealed.ValueString() == "" {
+		return false
+	}
+
+	return priorState.ValueHash.ValueString() == valueHash &&
+		priorState.PubKeyFingerprint.ValueString() == pubKeyFingerprint &&
+		priorState.Name.ValueString() == plan.Name.ValueString() &&
+		priorState.Namespace.ValueString() == plan.Namespace.ValueString() &&
+		priorState.Scope.ValueInt32() == plan.Scope.ValueInt32()
+}
+
 // Create a new resource.
 func (r *rawResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -123,7 +216,7 @@ func (r *rawResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 
 	var err error
-	plan, err = encryptWrapper(plan, resp.Diagnostics)
+	plan, err = encryptWrapper(ctx, r.providerData, nil, plan, resp.Diagnostics)
 	if err != nil {
 		return
 	}
@@ -145,8 +238,15 @@ func (r *rawResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	var priorState rawSealModel
+	diags = req.State.Get(ctx, &priorState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var err error
-	plan, err = encryptWrapper(plan, resp.Diagnostics)
+	plan, err = encryptWrapper(ctx, r.providerData, &priorState, plan, resp.Diagnostics)
 	if err != nil {
 		return
 	}
@@ -159,8 +259,86 @@ func (r *rawResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	}
 }
 
+// Read detects drift caused by the controller's pubkey rotating out from under a
+// previously sealed value, when force_recompute_on_pubkey_change is set; it does nothing
+// otherwise, so refresh never requires cluster/cert_url connectivity for resources that
+// don't opt in. Sealed-secrets ciphertext is non-deterministic and carries no recoverable
+// plaintext header or key fingerprint, so this cannot detect the ciphertext itself having
+// been hand-edited outside Terraform, nor re-derive name/namespace/scope from it; it only
+// compares the pubkey a value was sealed with against the currently discovered one. A
+// fetch failure while checking is reported as a warning, not a hard error, so refresh can
+// still succeed.
 func (r *rawResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state rawSealModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.providerData != nil && state.ForceRecomputeOnPubKeyChange.ValueBool() {
+		currentPubKeyPEM, err := r.providerData.PubKeyPEM(ctx)
+		if err != nil {
+			resp.Diagnostics.AddWarning("Unable to check for pubkey rotation", "Skipping pubkey rotation check: "+err.Error())
+			currentPubKeyPEM = ""
+		}
+
+		if currentPubKeyPEM != "" {
+			rotated, err := pubKeyRotated(state.PubKey.ValueString(), currentPubKeyPEM)
+			if err != nil {
+				resp.Diagnostics.AddWarning("Unable to check for pubkey rotation", "Skipping pubkey rotation check: "+err.Error())
+			} else if rotated {
+				state.PubKey = types.StringValue(currentPubKeyPEM)
+				state.Sealed = types.StringValue("")
+				state.PubKeyFingerprint = types.StringValue("")
+				state.ValueHash = types.StringValue("")
+			}
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *rawResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 }
+
+// ImportState brings an existing SealedSecret under management, either fetched from the
+// cluster (import ID "namespace/name") or read from a local manifest (import ID
+// "file://path/to/manifest.yaml"). kubeseal_raw models a single ciphertext value, so the
+// imported manifest must carry exactly one spec.encryptedData key.
+func (r *rawResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	sealedSecret, err := resolveImportedSealedSecret(ctx, r.providerData, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing SealedSecret", "Unexpected error: "+err.Error())
+		return
+	}
+
+	if len(sealedSecret.Spec.EncryptedData) != 1 {
+		resp.Diagnostics.AddError(
+			"Cannot import multi-key SealedSecret",
+			fmt.Sprintf("%q has %d encrypted data key(s); kubeseal_raw models exactly one. Use kubeseal_raws or kubeseal_secret instead.", req.ID, len(sealedSecret.Spec.EncryptedData)),
+		)
+		return
+	}
+
+	var sealed string
+	for _, v := range sealedSecret.Spec.EncryptedData {
+		sealed = v
+	}
+
+	state := rawSealModel{
+		Name:                         types.StringValue(sealedSecret.Name),
+		Namespace:                    types.StringValue(sealedSecret.Namespace),
+		Secret:                       types.StringValue(""),
+		Scope:                        types.Int32Value(int32(scopeFromAnnotations(sealedSecret.Annotations))),
+		PubKey:                       types.StringValue(""),
+		Sealed:                       types.StringValue(sealed),
+		ForceRecomputeOnPubKeyChange: types.BoolValue(false),
+		PubKeyFingerprint:            types.StringValue(""),
+		ValueHash:                    types.StringValue(""),
+		LastUpdated:                  types.StringValue(""),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCanReuseSealedValue(t *testing.T) {
+	base := rawSealModel{
+		Name:              types.StringValue("name"),
+		Namespace:         types.StringValue("namespace"),
+		Scope:             types.Int32Value(0),
+		PubKeyFingerprint: types.StringValue("fingerprint-a"),
+		ValueHash:         types.StringValue("hash-a"),
+		Sealed:            types.StringValue("AgA..."),
+		LastUpdated:       types.StringValue("last-updated"),
+	}
+
+	tests := []struct {
+		name       string
+		priorState *rawSealModel
+		plan       rawSealModel
+		fp         string
+		valueHash  string
+		want       bool
+	}{
+		{
+			name:       "no prior state",
+			priorState: nil,
+			plan:       base,
+			fp:         "fingerprint-a",
+			valueHash:  "hash-a",
+			want:       false,
+		},
+		{
+			name:       "prior state with empty sealed value",
+			priorState: &rawSealModel{Name: base.Name, Namespace: base.Namespace, Scope: base.Scope, PubKeyFingerprint: base.PubKeyFingerprint, ValueHash: base.ValueHash, Sealed: types.StringValue("")},
+			plan:       base,
+			fp:         "fingerprint-a",
+			valueHash:  "hash-a",
+			want:       false,
+		},
+		{
+			name:       "everything matches",
+			priorState: &base,
+			plan:       base,
+			fp:         "fingerprint-a",
+			valueHash:  "hash-a",
+			want:       true,
+		},
+		{
+			name:       "value hash changed",
+			priorState: &base,
+			plan:       base,
+			fp:         "fingerprint-a",
+			valueHash:  "hash-b",
+			want:       false,
+		},
+		{
+			name:       "pubkey fingerprint changed",
+			priorState: &base,
+			plan:       base,
+			fp:         "fingerprint-b",
+			valueHash:  "hash-a",
+			want:       false,
+		},
+		{
+			name:       "name changed",
+			priorState: &base,
+			plan:       rawSealModel{Name: types.StringValue("other"), Namespace: base.Namespace, Scope: base.Scope},
+			fp:         "fingerprint-a",
+			valueHash:  "hash-a",
+			want:       false,
+		},
+		{
+			name:       "namespace changed",
+			priorState: &base,
+			plan:       rawSealModel{Name: base.Name, Namespace: types.StringValue("other"), Scope: base.Scope},
+			fp:         "fingerprint-a",
+			valueHash:  "hash-a",
+			want:       false,
+		},
+		{
+			name:       "scope changed",
+			priorState: &base,
+			plan:       rawSealModel{Name: base.Name, Namespace: base.Namespace, Scope: types.Int32Value(1)},
+			fp:         "fingerprint-a",
+			valueHash:  "hash-a",
+			want:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := canReuseSealedValue(tt.priorState, tt.plan, tt.fp, tt.valueHash); got != tt.want {
+				t.Errorf("canReuseSealedValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,12 +3,16 @@ package provider
 import (
 	"bytes"
 	"context"
+	"fmt"
 	ssv1alpha1 "github.com/bitnami-labs/sealed-secrets/pkg/apis/sealedsecrets/v1alpha1"
 	"github.com/bitnami-labs/sealed-secrets/pkg/kubeseal"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strings"
@@ -17,7 +21,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &rawsResource{}
+	_ resource.Resource                = &rawsResource{}
+	_ resource.ResourceWithConfigure   = &rawsResource{}
+	_ resource.ResourceWithImportState = &rawsResource{}
 )
 
 func NewRawsResource() resource.Resource {
@@ -26,16 +32,38 @@ func NewRawsResource() resource.Resource {
 
 // rawsResource is the resource implementation.
 type rawsResource struct {
+	providerData *providerData
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *rawsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = data
 }
 
 type rawsSealModel struct {
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Values      types.Map    `tfsdk:"values"`
-	Scope       types.Int32  `tfsdk:"scope"`
-	PubKey      types.String `tfsdk:"pubkey"`
-	Sealed      types.Map    `tfsdk:"sealed"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	Name                         types.String `tfsdk:"name"`
+	Namespace                    types.String `tfsdk:"namespace"`
+	Values                       types.Map    `tfsdk:"values"`
+	Scope                        types.Int32  `tfsdk:"scope"`
+	PubKey                       types.String `tfsdk:"pubkey"`
+	Sealed                       types.Map    `tfsdk:"sealed"`
+	ForceRecomputeOnPubKeyChange types.Bool   `tfsdk:"force_recompute_on_pubkey_change"`
+	PubKeyFingerprint            types.String `tfsdk:"pubkey_fingerprint"`
+	ValueHashes                  types.Map    `tfsdk:"value_hashes"`
+	LastUpdated                  types.String `tfsdk:"last_updated"`
 }
 
 // Metadata returns the resource type name.
@@ -76,14 +104,38 @@ func (r *rawsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				`,
 			},
 			"pubkey": schema.StringAttribute{
-				Required:    true,
-				Description: "Public Key to encrypt secrets with",
+				Optional:    true,
+				Computed:    true,
+				Description: "Public Key to encrypt secrets with. Defaults to the provider's controller-discovered key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"sealed": schema.MapAttribute{
 				Computed:    true,
 				Description: "Map of encrypted secret values",
 				ElementType: types.StringType,
 			},
+			"force_recompute_on_pubkey_change": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When the provider-discovered pubkey rotates, clear the sealed values on refresh so the next plan re-encrypts them. Defaults to false.",
+			},
+			"pubkey_fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 fingerprint of the pubkey the sealed values were last encrypted with",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"value_hashes": schema.MapAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Map of SHA-256 hashes of each key's plaintext last sealed, used to avoid re-encrypting unchanged values on every apply",
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"last_updated": schema.StringAttribute{
 				Computed:    true,
 				Description: "Timestamp of last updated time",
@@ -92,7 +144,20 @@ func (r *rawsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
-func encryptMapWrapper(plan rawsSealModel, diagnostics diag.Diagnostics) (rawsSealModel, error) {
+// encryptMapWrapper seals plan.Values key by key, reusing each key's prior ciphertext
+// verbatim when priorState shows that key's plaintext, name, namespace, scope and pubkey
+// are all unchanged, rather than re-encrypting every key with fresh randomness on every
+// apply. priorState is nil on Create, where there's nothing to reuse.
+func encryptMapWrapper(ctx context.Context, providerData *providerData, priorState *rawsSealModel, plan rawsSealModel, diagnostics diag.Diagnostics) (rawsSealModel, error) {
+	if plan.PubKey.ValueString() == "" {
+		pubKeyPEM, err := resolvePubKey(ctx, providerData)
+		if err != nil {
+			diagnostics.AddError("Error resolving pubkey", "Unexpected error: "+err.Error())
+			return plan, err
+		}
+		plan.PubKey = types.StringValue(pubKeyPEM)
+	}
+
 	reader := strings.NewReader(plan.PubKey.ValueString())
 	pubKey, err := kubeseal.ParseKey(reader)
 	if err != nil {
@@ -100,19 +165,41 @@ func encryptMapWrapper(plan rawsSealModel, diagnostics diag.Diagnostics) (rawsSe
 		return plan, err
 	}
 
+	fingerprint, err := pubKeyFingerprint(pubKey)
+	if err != nil {
+		diagnostics.AddError("Error fingerprinting pubkey", "Unexpected error: "+err.Error())
+		return plan, err
+	}
+
 	sealingScope := ssv1alpha1.SealingScope(plan.Scope.ValueInt32())
 
 	// Extract the values map
 	values := make(map[string]string)
-	diags := plan.Values.ElementsAs(context.Background(), &values, false)
+	diags := plan.Values.ElementsAs(ctx, &values, false)
 	if diags.HasError() {
 		diagnostics.Append(diags...)
 		return plan, err
 	}
 
-	// Encrypt each value in the map
-	sealedValues := make(map[string]string)
+	priorSealed, priorHashes := priorMapState(ctx, priorState)
+	sameCoordinates := priorState != nil &&
+		priorState.PubKeyFingerprint.ValueString() == fingerprint &&
+		priorState.Name.ValueString() == plan.Name.ValueString() &&
+		priorState.Namespace.ValueString() == plan.Namespace.ValueString() &&
+		priorState.Scope.ValueInt32() == plan.Scope.ValueInt32()
+
+	// Encrypt each value in the map, reusing unchanged keys verbatim
+	sealedValues := make(map[string]string, len(values))
+	valueHashes := make(map[string]string, len(values))
 	for key, value := range values {
+		valueHash := sha256Hex(value)
+		valueHashes[key] = valueHash
+
+		if sameCoordinates && priorHashes[key] == valueHash && priorSealed[key] != "" {
+			sealedValues[key] = priorSealed[key]
+			continue
+		}
+
 		w := new(bytes.Buffer)
 		err = kubeseal.EncryptSecretItem(w, plan.Name.ValueString(), plan.Namespace.ValueString(), []byte(value), sealingScope, pubKey)
 		if err != nil {
@@ -122,18 +209,57 @@ func encryptMapWrapper(plan rawsSealModel, diagnostics diag.Diagnostics) (rawsSe
 		sealedValues[key] = w.String()
 	}
 
-	// Convert the sealed values map to types.Map
-	sealedMap, diags := types.MapValueFrom(context.Background(), types.StringType, sealedValues)
+	// Convert the sealed values and value hashes maps to types.Map
+	sealedMap, diags := types.MapValueFrom(ctx, types.StringType, sealedValues)
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return plan, err
+	}
+
+	valueHashesMap, diags := types.MapValueFrom(ctx, types.StringType, valueHashes)
 	if diags.HasError() {
 		diagnostics.Append(diags...)
 		return plan, err
 	}
 
 	plan.Sealed = sealedMap
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.PubKeyFingerprint = types.StringValue(fingerprint)
+	plan.ValueHashes = valueHashesMap
+	if sameCoordinates && mapsEqual(sealedValues, priorSealed) {
+		plan.LastUpdated = priorState.LastUpdated
+	} else {
+		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	}
 	return plan, nil
 }
 
+// priorMapState extracts the sealed ciphertext and value hash maps from priorState, or
+// empty maps if there's no prior state (Create) or the maps aren't set.
+func priorMapState(ctx context.Context, priorState *rawsSealModel) (sealed, hashes map[string]string) {
+	sealed = make(map[string]string)
+	hashes = make(map[string]string)
+	if priorState == nil {
+		return sealed, hashes
+	}
+
+	_ = priorState.Sealed.ElementsAs(ctx, &sealed, false)
+	_ = priorState.ValueHashes.ElementsAs(ctx, &hashes, false)
+	return sealed, hashes
+}
+
+// mapsEqual reports whether a and b contain the same keys and values.
+func mapsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		if b[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
 // Create a new resource.
 func (r *rawsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -145,7 +271,7 @@ func (r *rawsResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	var err error
-	plan, err = encryptMapWrapper(plan, resp.Diagnostics)
+	plan, err = encryptMapWrapper(ctx, r.providerData, nil, plan, resp.Diagnostics)
 	if err != nil {
 		return
 	}
@@ -167,8 +293,15 @@ func (r *rawsResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	var priorState rawsSealModel
+	diags = req.State.Get(ctx, &priorState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var err error
-	plan, err = encryptMapWrapper(plan, resp.Diagnostics)
+	plan, err = encryptMapWrapper(ctx, r.providerData, &priorState, plan, resp.Diagnostics)
 	if err != nil {
 		return
 	}
@@ -181,10 +314,111 @@ func (r *rawsResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 }
 
+// Read detects drift caused by the controller's pubkey rotating out from under previously
+// sealed values, when force_recompute_on_pubkey_change is set; it does nothing otherwise,
+// so refresh never requires cluster/cert_url connectivity for resources that don't opt in.
+// A fetch failure while checking is reported as a warning, not a hard error, so refresh can
+// still succeed. See rawResource.Read for why name/namespace/scope, and hand-edited
+// ciphertext, can't be detected from the ciphertext itself.
 func (r *rawsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// No-op for now
+	var state rawsSealModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.providerData != nil && state.ForceRecomputeOnPubKeyChange.ValueBool() {
+		currentPubKeyPEM, err := r.providerData.PubKeyPEM(ctx)
+		if err != nil {
+			resp.Diagnostics.AddWarning("Unable to check for pubkey rotation", "Skipping pubkey rotation check: "+err.Error())
+			currentPubKeyPEM = ""
+		}
+
+		if currentPubKeyPEM != "" {
+			rotated, err := pubKeyRotated(state.PubKey.ValueString(), currentPubKeyPEM)
+			if err != nil {
+				resp.Diagnostics.AddWarning("Unable to check for pubkey rotation", "Skipping pubkey rotation check: "+err.Error())
+				rotated = false
+			}
+
+			if rotated {
+				state.PubKey = types.StringValue(currentPubKeyPEM)
+				state.PubKeyFingerprint = types.StringValue("")
+
+				clearedValues := make(map[string]string)
+				diags := state.Sealed.ElementsAs(ctx, &clearedValues, false)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				for key := range clearedValues {
+					clearedValues[key] = ""
+				}
+
+				sealedMap, diags := types.MapValueFrom(ctx, types.StringType, clearedValues)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				state.Sealed = sealedMap
+				state.ValueHashes = sealedMap
+			}
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *rawsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// No-op for now
 }
+
+// ImportState brings an existing SealedSecret under management, either fetched from the
+// cluster (import ID "namespace/name") or read from a local manifest (import ID
+// "file://path/to/manifest.yaml").
+func (r *rawsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	sealedSecret, err := resolveImportedSealedSecret(ctx, r.providerData, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing SealedSecret", "Unexpected error: "+err.Error())
+		return
+	}
+
+	sealedMap, diags := types.MapValueFrom(ctx, types.StringType, sealedSecret.Spec.EncryptedData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	emptyValues := make(map[string]string, len(sealedSecret.Spec.EncryptedData))
+	for key := range sealedSecret.Spec.EncryptedData {
+		emptyValues[key] = ""
+	}
+	valuesMap, diags := types.MapValueFrom(ctx, types.StringType, emptyValues)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	emptyHashesMap, diags := types.MapValueFrom(ctx, types.StringType, emptyValues)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := rawsSealModel{
+		Name:                         types.StringValue(sealedSecret.Name),
+		Namespace:                    types.StringValue(sealedSecret.Namespace),
+		Values:                       valuesMap,
+		Scope:                        types.Int32Value(int32(scopeFromAnnotations(sealedSecret.Annotations))),
+		PubKey:                       types.StringValue(""),
+		Sealed:                       sealedMap,
+		ForceRecomputeOnPubKeyChange: types.BoolValue(false),
+		PubKeyFingerprint:            types.StringValue(""),
+		ValueHashes:                  emptyHashesMap,
+		LastUpdated:                  types.StringValue(""),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMapsEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b map[string]string
+		want bool
+	}{
+		{name: "both empty", a: map[string]string{}, b: map[string]string{}, want: true},
+		{name: "equal", a: map[string]string{"k": "v"}, b: map[string]string{"k": "v"}, want: true},
+		{name: "different lengths", a: map[string]string{"k": "v"}, b: map[string]string{}, want: false},
+		{name: "different values", a: map[string]string{"k": "v1"}, b: map[string]string{"k": "v2"}, want: false},
+		{name: "different keys", a: map[string]string{"k1": "v"}, b: map[string]string{"k2": "v"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mapsEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("mapsEqual() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPriorMapState(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("nil prior state", func(t *testing.T) {
+		sealed, hashes := priorMapState(ctx, nil)
+		if len(sealed) != 0 || len(hashes) != 0 {
+			t.Errorf("priorMapState(nil) = %v, %v, want empty maps", sealed, hashes)
+		}
+	})
+
+	t.Run("populated prior state", func(t *testing.T) {
+		sealedMap, diags := types.MapValueFrom(ctx, types.StringType, map[string]string{"k": "sealed-value"})
+		if diags.HasError() {
+			t.Fatalf("building sealed map: %v", diags)
+		}
+		hashesMap, diags := types.MapValueFrom(ctx, types.StringType, map[string]string{"k": "hash-value"})
+		if diags.HasError() {
+			t.Fatalf("building hashes map: %v", diags)
+		}
+
+		priorState := &rawsSealModel{Sealed: sealedMap, ValueHashes: hashesMap}
+		sealed, hashes := priorMapState(ctx, priorState)
+
+		if sealed["k"] != "sealed-value" {
+			t.Errorf("priorMapState() sealed[\"k\"] = %q, want %q", sealed["k"], "sealed-value")
+		}
+		if hashes["k"] != "hash-value" {
+			t.Errorf("priorMapState() hashes[\"k\"] = %q, want %q", hashes["k"], "hash-value")
+		}
+	})
+}
@@ -0,0 +1,403 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	ssv1alpha1 "github.com/bitnami-labs/sealed-secrets/pkg/apis/sealedsecrets/v1alpha1"
+	"github.com/bitnami-labs/sealed-secrets/pkg/kubeseal"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/yaml"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &secretResource{}
+	_ resource.ResourceWithConfigure   = &secretResource{}
+	_ resource.ResourceWithImportState = &secretResource{}
+)
+
+func NewSecretResource() resource.Resource {
+	return &secretResource{}
+}
+
+// secretResource is the resource implementation.
+type secretResource struct {
+	providerData *providerData
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *secretResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = data
+}
+
+type secretMetadataModel struct {
+	Name        types.String `tfsdk:"name"`
+	Namespace   types.String `tfsdk:"namespace"`
+	Labels      types.Map    `tfsdk:"labels"`
+	Annotations types.Map    `tfsdk:"annotations"`
+}
+
+type secretResourceModel struct {
+	Metadata      secretMetadataModel `tfsdk:"metadata"`
+	Data          types.Map           `tfsdk:"data"`
+	StringData    types.Map           `tfsdk:"string_data"`
+	Type          types.String        `tfsdk:"type"`
+	Scope         types.Int32         `tfsdk:"scope"`
+	PubKey        types.String        `tfsdk:"pubkey"`
+	YAML          types.String        `tfsdk:"yaml"`
+	EncryptedData types.Map           `tfsdk:"encrypted_data"`
+	LastUpdated   types.String        `tfsdk:"last_updated"`
+}
+
+// Metadata returns the resource type name.
+func (r *secretResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+// Schema defines the schema for the resource.
+func (r *secretResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Produces a complete bitnami.com/v1alpha1 SealedSecret manifest from plain text data, ready to be applied with kubectl or kubectl_manifest.",
+		Attributes: map[string]schema.Attribute{
+			"metadata": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "Standard secret metadata.",
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required:    true,
+						Description: "Name of the secret",
+					},
+					"namespace": schema.StringAttribute{
+						Required:    true,
+						Description: "Namespace of the secret",
+					},
+					"labels": schema.MapAttribute{
+						Optional:    true,
+						Description: "Labels to apply to the secret",
+						ElementType: types.StringType,
+					},
+					"annotations": schema.MapAttribute{
+						Optional:    true,
+						Description: "Annotations to apply to the secret",
+						ElementType: types.StringType,
+					},
+				},
+			},
+			"data": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Map of secret key-value pairs to be encrypted, as plain text",
+				ElementType: types.StringType,
+			},
+			"string_data": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Map of secret key-value pairs to be encrypted, as plain text strings",
+				ElementType: types.StringType,
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Type of the underlying secret. Defaults to \"Opaque\".",
+			},
+			"scope": schema.Int32Attribute{
+				Description: "Sealed secret scope: 0 strict | 1 namespace-wide | 2 cluster-wide",
+				Required:    true,
+				Validators: []validator.Int32{
+					int32validator.Between(0, 2),
+				},
+				MarkdownDescription: `
+				0 strict: the secret must be sealed with exactly the same name and namespace.
+				1 namespace-wide: you can freely rename the sealed secret within a given namespace.
+				2 cluster-wide: the secret can be unsealed in any namespace and can be given any name.
+				[Official Docs](https://github.com/bitnami-labs/sealed-secrets/tree/main?tab=readme-ov-file#scopes)
+				`,
+			},
+			"pubkey": schema.StringAttribute{
+				Required:    true,
+				Description: "Public Key to encrypt secret with",
+			},
+			"yaml": schema.StringAttribute{
+				Computed:    true,
+				Description: "Rendered SealedSecret manifest, ready to apply to the cluster",
+			},
+			"encrypted_data": schema.MapAttribute{
+				Computed:    true,
+				Description: "spec.encryptedData of the rendered SealedSecret",
+				ElementType: types.StringType,
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of last updated time",
+			},
+		},
+	}
+}
+
+func sealedSecretCodecs() serializer.CodecFactory {
+	return serializer.NewCodecFactory(ssv1alpha1.Scheme)
+}
+
+func encryptSecretWrapper(ctx context.Context, plan secretResourceModel, diagnostics diag.Diagnostics) (secretResourceModel, error) {
+	reader := strings.NewReader(plan.PubKey.ValueString())
+	pubKey, err := kubeseal.ParseKey(reader)
+	if err != nil {
+		diagnostics.AddError("Error parsing pubkey", "Unexpected error: "+err.Error())
+		return plan, err
+	}
+
+	secretType := plan.Type.ValueString()
+	if secretType == "" {
+		secretType = string(corev1.SecretTypeOpaque)
+	}
+
+	labels := make(map[string]string)
+	if !plan.Metadata.Labels.IsNull() {
+		diags := plan.Metadata.Labels.ElementsAs(ctx, &labels, false)
+		if diags.HasError() {
+			diagnostics.Append(diags...)
+			return plan, err
+		}
+	}
+
+	annotations := make(map[string]string)
+	if !plan.Metadata.Annotations.IsNull() {
+		diags := plan.Metadata.Annotations.ElementsAs(ctx, &annotations, false)
+		if diags.HasError() {
+			diagnostics.Append(diags...)
+			return plan, err
+		}
+	}
+
+	data := make(map[string]string)
+	if !plan.Data.IsNull() {
+		diags := plan.Data.ElementsAs(ctx, &data, false)
+		if diags.HasError() {
+			diagnostics.Append(diags...)
+			return plan, err
+		}
+	}
+
+	stringData := make(map[string]string)
+	if !plan.StringData.IsNull() {
+		diags := plan.StringData.ElementsAs(ctx, &stringData, false)
+		if diags.HasError() {
+			diagnostics.Append(diags...)
+			return plan, err
+		}
+	}
+
+	secretData := make(map[string][]byte, len(data))
+	for key, value := range data {
+		secretData[key] = []byte(value)
+	}
+
+	// NewSealedSecret derives the encryption label from the secret's own scope annotations,
+	// so they carry plan.Scope here; spec.template gets the user's annotations back below.
+	secretAnnotations := make(map[string]string, len(annotations)+1)
+	for key, value := range annotations {
+		secretAnnotations[key] = value
+	}
+	delete(secretAnnotations, ssv1alpha1.SealedSecretClusterWideAnnotation)
+	delete(secretAnnotations, ssv1alpha1.SealedSecretNamespaceWideAnnotation)
+	for key, value := range scopeAnnotations(ssv1alpha1.SealingScope(plan.Scope.ValueInt32())) {
+		secretAnnotations[key] = value
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        plan.Metadata.Name.ValueString(),
+			Namespace:   plan.Metadata.Namespace.ValueString(),
+			Labels:      labels,
+			Annotations: secretAnnotations,
+		},
+		Data:       secretData,
+		StringData: stringData,
+		Type:       corev1.SecretType(secretType),
+	}
+
+	sealedSecret, err := ssv1alpha1.NewSealedSecret(sealedSecretCodecs(), pubKey, secret)
+	if err != nil {
+		diagnostics.AddError("Error creating sealed secret", "Unexpected error: "+err.Error())
+		return plan, err
+	}
+	sealedSecret.Spec.Template.Annotations = annotations
+	sealedSecret.SetAnnotations(scopeAnnotations(ssv1alpha1.SealingScope(plan.Scope.ValueInt32())))
+
+	// The upstream controller (bda0af6) rejects manifests carrying an empty
+	// spec.data, so spec.template.data is cleared when the plaintext data map is empty.
+	if len(secretData) == 0 {
+		sealedSecret.Spec.Template.Data = nil
+	}
+
+	manifest, err := yaml.Marshal(sealedSecret)
+	if err != nil {
+		diagnostics.AddError("Error rendering SealedSecret manifest", "Unexpected error: "+err.Error())
+		return plan, err
+	}
+
+	encryptedData, diags := types.MapValueFrom(ctx, types.StringType, sealedSecret.Spec.EncryptedData)
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return plan, err
+	}
+
+	plan.Type = types.StringValue(secretType)
+	plan.YAML = types.StringValue(string(manifest))
+	plan.EncryptedData = encryptedData
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	return plan, nil
+}
+
+// scopeAnnotations returns the bitnami.com scope annotations matching the given scope,
+// mirroring how the sealed-secrets controller infers scope from an existing manifest.
+func scopeAnnotations(scope ssv1alpha1.SealingScope) map[string]string {
+	switch scope {
+	case ssv1alpha1.ClusterWideScope:
+		return map[string]string{ssv1alpha1.SealedSecretClusterWideAnnotation: "true"}
+	case ssv1alpha1.NamespaceWideScope:
+		return map[string]string{ssv1alpha1.SealedSecretNamespaceWideAnnotation: "true"}
+	default:
+		return nil
+	}
+}
+
+// Create a new resource.
+func (r *secretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan secretResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	plan, err = encryptSecretWrapper(ctx, plan, resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *secretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan secretResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	plan, err = encryptSecretWrapper(ctx, plan, resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *secretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+func (r *secretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// ImportState brings an existing SealedSecret manifest under management, either fetched
+// from the cluster (import ID "namespace/name") or read from a local manifest (import ID
+// "file://path/to/manifest.yaml"). The plaintext data/string_data can never be recovered
+// from ciphertext, so they're left unset; the next apply will re-seal if they're configured.
+func (r *secretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	sealedSecret, err := resolveImportedSealedSecret(ctx, r.providerData, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing SealedSecret", "Unexpected error: "+err.Error())
+		return
+	}
+
+	manifest, err := yaml.Marshal(sealedSecret)
+	if err != nil {
+		resp.Diagnostics.AddError("Error rendering SealedSecret manifest", "Unexpected error: "+err.Error())
+		return
+	}
+
+	encryptedData, diags := types.MapValueFrom(ctx, types.StringType, sealedSecret.Spec.EncryptedData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The user's metadata.labels/annotations land on spec.template (see
+	// encryptSecretWrapper); the outer SealedSecret's own annotations only carry the scope
+	// marker, not the secret's real annotations.
+	labels, diags := types.MapValueFrom(ctx, types.StringType, sealedSecret.Spec.Template.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	annotations, diags := types.MapValueFrom(ctx, types.StringType, sealedSecret.Spec.Template.Annotations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretType := sealedSecret.Spec.Template.Type
+	if secretType == "" {
+		secretType = corev1.SecretTypeOpaque
+	}
+
+	state := secretResourceModel{
+		Metadata: secretMetadataModel{
+			Name:        types.StringValue(sealedSecret.Name),
+			Namespace:   types.StringValue(sealedSecret.Namespace),
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Data:          types.MapNull(types.StringType),
+		StringData:    types.MapNull(types.StringType),
+		Type:          types.StringValue(string(secretType)),
+		Scope:         types.Int32Value(int32(scopeFromAnnotations(sealedSecret.Annotations))),
+		PubKey:        types.StringValue(""),
+		YAML:          types.StringValue(string(manifest)),
+		EncryptedData: encryptedData,
+		LastUpdated:   types.StringValue(""),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}